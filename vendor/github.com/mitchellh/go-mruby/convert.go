@@ -0,0 +1,271 @@
+package mruby
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToInterface recursively converts this value into an idiomatic Go
+// value: bool, int64, float64, string, Symbol, []interface{},
+// map[string]interface{} (or map[interface{}]interface{} when the hash
+// has non-string/symbol keys), or nil. Arrays and hashes are walked
+// recursively so nested structures come back fully converted. Object
+// types with no native Go equivalent fall back to their `to_s` string.
+func (v *MrbValue) ToInterface() interface{} {
+	switch v.Type() {
+	case TypeFalse:
+		if v.IsNil() {
+			return nil
+		}
+		return false
+	case TypeTrue:
+		return true
+	case TypeFixnum:
+		return int64(v.Fixnum())
+	case TypeFloat:
+		return v.Float()
+	case TypeString:
+		return v.String()
+	case TypeSymbol:
+		return Symbol(v.Symbol())
+	case TypeUndef:
+		return nil
+	case TypeArray:
+		return v.arrayToInterface()
+	case TypeHash:
+		return v.hashToInterface()
+	default:
+		return v.String()
+	}
+}
+
+func (v *MrbValue) arrayToInterface() []interface{} {
+	out := []interface{}{}
+	err := v.Array().Each(func(i int, elem *MrbValue) error {
+		out = append(out, elem.ToInterface())
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+
+	return out
+}
+
+func (v *MrbValue) hashToInterface() interface{} {
+	type pair struct {
+		key *MrbValue
+		val *MrbValue
+	}
+
+	var pairs []pair
+	stringKeys := true
+
+	err := v.Hash().EachPair(func(k, val *MrbValue) error {
+		if k.Type() != TypeString && k.Type() != TypeSymbol {
+			stringKeys = false
+		}
+		pairs = append(pairs, pair{k, val})
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+
+	if stringKeys {
+		out := make(map[string]interface{}, len(pairs))
+		for _, p := range pairs {
+			out[p.key.String()] = p.val.ToInterface()
+		}
+		return out
+	}
+
+	out := make(map[interface{}]interface{}, len(pairs))
+	for _, p := range pairs {
+		out[mapKey(p.key)] = p.val.ToInterface()
+	}
+	return out
+}
+
+// mapKey returns a hashable Go representation of v for use as a
+// map[interface{}]interface{} key. Array and Hash values convert to
+// slices and maps via ToInterface, which Go can't use as map keys, so
+// those fall back to their `to_s` string instead of panicking.
+func mapKey(v *MrbValue) interface{} {
+	switch v.Type() {
+	case TypeArray, TypeHash:
+		return v.String()
+	default:
+		return v.ToInterface()
+	}
+}
+
+// Decode recursively fills out, which must be a non-nil pointer, from
+// this value the same way encoding/json.Unmarshal fills a Go value from
+// JSON: scalars are assigned directly, arrays fill slices, and hashes
+// fill maps or structs. A struct field can override the default lookup
+// (its Go field name) with an `mruby:"name"` tag.
+func (v *MrbValue) Decode(out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("mruby: Decode requires a non-nil pointer, got %T", out)
+	}
+
+	return v.decode(rv.Elem())
+}
+
+func (v *MrbValue) decode(rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, ok := v.ToInterface().(bool)
+		if !ok {
+			return fmt.Errorf("mruby: cannot decode %v into bool", v.Type())
+		}
+		rv.SetBool(b)
+	case reflect.String:
+		rv.SetString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() != TypeFixnum {
+			return fmt.Errorf("mruby: cannot decode %v into %s", v.Type(), rv.Kind())
+		}
+		rv.SetInt(int64(v.Fixnum()))
+	case reflect.Float32, reflect.Float64:
+		if v.Type() != TypeFloat {
+			return fmt.Errorf("mruby: cannot decode %v into %s", v.Type(), rv.Kind())
+		}
+		rv.SetFloat(v.Float())
+	case reflect.Slice:
+		return v.decodeSlice(rv)
+	case reflect.Map:
+		return v.decodeMap(rv)
+	case reflect.Struct:
+		return v.decodeStruct(rv)
+	case reflect.Interface:
+		if iv := v.ToInterface(); iv != nil {
+			rv.Set(reflect.ValueOf(iv))
+		} else {
+			rv.Set(reflect.Zero(rv.Type()))
+		}
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return v.decode(rv.Elem())
+	default:
+		return fmt.Errorf("mruby: unsupported decode target %s", rv.Kind())
+	}
+
+	return nil
+}
+
+func (v *MrbValue) decodeSlice(rv reflect.Value) error {
+	if v.Type() != TypeArray {
+		return fmt.Errorf("mruby: cannot decode %v into %s", v.Type(), rv.Type())
+	}
+
+	a := v.Array()
+	n, err := a.Len()
+	if err != nil {
+		return err
+	}
+
+	out := reflect.MakeSlice(rv.Type(), n, n)
+	for i := 0; i < n; i++ {
+		elem, err := a.Get(i)
+		if err != nil {
+			return err
+		}
+
+		if err := elem.decode(out.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	rv.Set(out)
+	return nil
+}
+
+func (v *MrbValue) decodeMap(rv reflect.Value) error {
+	if v.Type() != TypeHash {
+		return fmt.Errorf("mruby: cannot decode %v into %s", v.Type(), rv.Type())
+	}
+
+	h := v.Hash()
+	keys, err := h.Keys()
+	if err != nil {
+		return err
+	}
+
+	keysArray := keys.Array()
+	n, err := keysArray.Len()
+	if err != nil {
+		return err
+	}
+
+	out := reflect.MakeMapWithSize(rv.Type(), n)
+	for i := 0; i < n; i++ {
+		key, err := keysArray.Get(i)
+		if err != nil {
+			return err
+		}
+
+		val, err := h.Get(key)
+		if err != nil {
+			return err
+		}
+
+		keyVal := reflect.New(rv.Type().Key()).Elem()
+		if err := key.decode(keyVal); err != nil {
+			return err
+		}
+
+		elemVal := reflect.New(rv.Type().Elem()).Elem()
+		if err := val.decode(elemVal); err != nil {
+			return err
+		}
+
+		out.SetMapIndex(keyVal, elemVal)
+	}
+
+	rv.Set(out)
+	return nil
+}
+
+func (v *MrbValue) decodeStruct(rv reflect.Value) error {
+	if v.Type() != TypeHash {
+		return fmt.Errorf("mruby: cannot decode %v into %s", v.Type(), rv.Type())
+	}
+
+	h := v.Hash()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Tag.Get("mruby")
+		if name == "" {
+			name = field.Name
+		}
+
+		// Ruby hash literals written with `key: value` syntax store
+		// Symbol keys, not String keys, and the two don't compare
+		// equal under Hash#==. Try the Symbol form first since that's
+		// what config/DSL-style hashes actually use, and fall back to
+		// a String key for hashes built with `"key" => value`.
+		val, err := h.Get(Symbol(name))
+		if err != nil || val == nil || val.IsNil() {
+			val, err = h.Get(String(name))
+		}
+		if err != nil || val == nil || val.IsNil() {
+			continue
+		}
+
+		if err := val.decode(rv.Field(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
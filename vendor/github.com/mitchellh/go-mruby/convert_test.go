@@ -0,0 +1,96 @@
+package mruby
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToInterface(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`{name: "Ruby", tags: ["fast", "fun"], meta: {major: 3, stable: true}, nope: nil}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	got := value.ToInterface()
+	expect := map[string]interface{}{
+		"name": "Ruby",
+		"tags": []interface{}{"fast", "fun"},
+		"meta": map[string]interface{}{"major": int64(3), "stable": true},
+		"nope": nil,
+	}
+
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("bad: %#v", got)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`{name: "Ruby", version: 3, tags: ["fast", "fun"]}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var out struct {
+		Name    string   `mruby:"name"`
+		Version int      `mruby:"version"`
+		Tags    []string `mruby:"tags"`
+	}
+
+	if err := value.Decode(&out); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if out.Name != "Ruby" || out.Version != 3 || !reflect.DeepEqual(out.Tags, []string{"fast", "fun"}) {
+		t.Fatalf("bad: %#v", out)
+	}
+}
+
+func TestDecodeStringKeys(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`{"name" => "Ruby", "version" => 3}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var out struct {
+		Name    string `mruby:"name"`
+		Version int    `mruby:"version"`
+	}
+
+	if err := value.Decode(&out); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if out.Name != "Ruby" || out.Version != 3 {
+		t.Fatalf("bad: %#v", out)
+	}
+}
+
+func TestToInterfaceNonScalarKeys(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`{[1, 2] => "coords", {a: 1} => "nested"}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Must not panic converting Array/Hash keys, which aren't valid Go
+	// map keys once converted to their native representation.
+	got, ok := value.ToInterface().(map[interface{}]interface{})
+	if !ok {
+		t.Fatalf("bad: %#v", got)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("bad: %#v", got)
+	}
+}
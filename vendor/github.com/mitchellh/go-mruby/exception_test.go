@@ -0,0 +1,32 @@
+package mruby
+
+import (
+	"testing"
+)
+
+func TestExceptionBacktrace(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	_, err := mrb.LoadString(`raise "kaboom"`)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	exc, ok := err.(*Exception)
+	if !ok {
+		t.Fatalf("expected *Exception, got %T", err)
+	}
+
+	if exc.Message == "" {
+		t.Fatal("expected a non-empty message")
+	}
+
+	if len(exc.Backtrace) == 0 {
+		t.Fatal("expected a non-empty backtrace")
+	}
+
+	if exc.BacktraceString() == "" {
+		t.Fatal("expected a non-empty backtrace string")
+	}
+}
@@ -0,0 +1,26 @@
+package mruby
+
+// #include "gomruby.h"
+import "C"
+
+// ArenaSave snapshots the current GC arena index. Pair with
+// ArenaRestore to reclaim every value allocated since the snapshot,
+// without disturbing anything rooted before it. Useful for callers
+// that, like call, marshal their own values into mruby in a loop.
+func (m *Mrb) ArenaSave() int {
+	return int(C.mrb_gc_arena_save(m.state))
+}
+
+// ArenaRestore releases every arena entry allocated since the matching
+// ArenaSave, making them eligible for collection.
+func (m *Mrb) ArenaRestore(idx int) {
+	C.mrb_gc_arena_restore(m.state, C.int(idx))
+}
+
+// GCProtect roots a value by pushing it onto the GC arena at the
+// current index, so a later ArenaRestore to an earlier index releases
+// it again. To keep a value allocated after an ArenaSave, call this
+// after the matching ArenaRestore, the way call does with its result.
+func (m *Mrb) GCProtect(v Value) {
+	C.mrb_gc_protect(m.state, v.MrbValue(m).value)
+}
@@ -0,0 +1,64 @@
+package mruby
+
+import (
+	"testing"
+)
+
+// TestArenaGrowthBounded guards against the GC arena index climbing
+// forever across a long-running session instead of settling back down
+// after each call.
+func TestArenaGrowthBounded(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping arena stress test in short mode")
+	}
+
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	const iterations = 2000000
+
+	startIdx := mrb.ArenaSave()
+
+	for i := 0; i < iterations; i++ {
+		if _, err := mrb.LoadString(`1 + 1`); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if endIdx := mrb.ArenaSave(); endIdx-startIdx > 1000 {
+		t.Fatalf("arena grew unbounded: index went from %d to %d over %d iterations", startIdx, endIdx, iterations)
+	}
+}
+
+// TestCallResultSurvivesArenaRestore exercises Call directly (rather
+// than LoadString) and checks that the value it hands back is still
+// intact after a burst of unrelated allocations that would sweep
+// anything left unprotected on the arena.
+func TestCallResultSurvivesArenaRestore(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	obj, err := mrb.LoadString(`"hello"`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	const iterations = 100000
+
+	for i := 0; i < iterations; i++ {
+		result, err := obj.Call("upcase")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		// Churn the arena between getting the result and using it, the
+		// way unrelated work elsewhere in a caller's loop would.
+		if _, err := mrb.LoadString(`"garbage" * 10`); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if result.String() != "HELLO" {
+			t.Fatalf("call result corrupted by arena churn on iteration %d: got %q", i, result.String())
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package mruby
+
+// Each iterates over every element of this array, calling f with each
+// element's index and value in order. f can return an error to stop
+// iteration early; that error is returned to the caller.
+func (a *Array) Each(f func(i int, v *MrbValue) error) error {
+	n, err := a.Len()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		v, err := a.Get(i)
+		if err != nil {
+			return err
+		}
+
+		if err := f(i, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EachPair iterates over every key/value pair in this hash, calling f
+// with each. It streams pairs off of mrb_hash_keys rather than asking
+// callers to build and hold their own keys array on every lookup.
+func (h *Hash) EachPair(f func(k, v *MrbValue) error) error {
+	keys, err := h.Keys()
+	if err != nil {
+		return err
+	}
+
+	return keys.Array().Each(func(i int, k *MrbValue) error {
+		v, err := h.Get(k)
+		if err != nil {
+			return err
+		}
+
+		return f(k, v)
+	})
+}
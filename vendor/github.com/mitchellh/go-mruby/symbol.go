@@ -0,0 +1,33 @@
+package mruby
+
+import "unsafe"
+
+// #include <stdlib.h>
+// #include "gomruby.h"
+import "C"
+
+// Symbol is a Go representation of an mruby Symbol literal (`:foo`). It
+// implements Value via SymbolValue rather than going through mruby's
+// String type, so a Symbol stays distinct from a String with the same
+// characters on both sides of the Go/Ruby boundary.
+type Symbol string
+
+func (s Symbol) MrbValue(m *Mrb) *MrbValue {
+	return m.SymbolValue(string(s))
+}
+
+// SymbolValue interns name and returns it as a TypeSymbol MrbValue.
+func (m *Mrb) SymbolValue(name string) *MrbValue {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+
+	sym := C.mrb_intern_cstr(m.state, cs)
+	return newValue(m.state, C.mrb_symbol_value(sym))
+}
+
+// Symbol returns the name of this value. Calling this with anything
+// other than a TypeSymbol value will result in undefined behavior.
+func (v *MrbValue) Symbol() string {
+	sym := C._go_mrb_symbol(v.value)
+	return C.GoString(C.mrb_sym2name(v.state, sym))
+}
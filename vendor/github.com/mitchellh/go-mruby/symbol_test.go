@@ -0,0 +1,82 @@
+package mruby
+
+import (
+	"testing"
+)
+
+func TestSymbol(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`:foo`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if value.Type() != TypeSymbol {
+		t.Fatalf("bad: %v", value.Type())
+	}
+
+	if value.Symbol() != "foo" {
+		t.Fatalf("bad: %s", value.Symbol())
+	}
+
+	result, err := mrb.LoadString(`lambda { |s| s == :foo }`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ok, err := result.Call("call", Symbol("foo"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if ok.Type() != TypeTrue {
+		t.Fatalf("bad: %v", ok.Type())
+	}
+}
+
+func TestArrayEach(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`[1, 2, 3]`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	sum := 0
+	err = value.Array().Each(func(i int, v *MrbValue) error {
+		sum += v.Fixnum()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if sum != 6 {
+		t.Fatalf("bad: %d", sum)
+	}
+}
+
+func TestHashEachPair(t *testing.T) {
+	mrb := NewMrb()
+	defer mrb.Close()
+
+	value, err := mrb.LoadString(`{foo: 1, bar: 2}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	seen := map[string]int{}
+	err = value.Hash().EachPair(func(k, v *MrbValue) error {
+		seen[k.Symbol()] = v.Fixnum()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if seen["foo"] != 1 || seen["bar"] != 2 || len(seen) != 2 {
+		t.Fatalf("bad: %#v", seen)
+	}
+}
@@ -2,6 +2,8 @@ package mruby
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"unsafe"
 )
 
@@ -84,6 +86,14 @@ func (v *MrbValue) CallBlock(method string, args ...Value) (*MrbValue, error) {
 }
 
 func (v *MrbValue) call(method string, args []Value, block Value) (*MrbValue, error) {
+	// Calling into mruby allocates intermediate values (argument
+	// conversions, the interned method name, the return value) on the
+	// GC arena, and callers in a tight loop (servers, template
+	// rendering) can pile up enough of them to stall collection. Save
+	// a snapshot now and clear everything pushed after it once we have
+	// our result in hand.
+	arenaIdx := C.mrb_gc_arena_save(v.state)
+
 	var argv []C.mrb_value = nil
 	var argvPtr *C.mrb_value = nil
 
@@ -126,9 +136,16 @@ func (v *MrbValue) call(method string, args []Value, block Value) (*MrbValue, er
 			*blockV)
 	}
 	if v.state.exc != nil {
+		C.mrb_gc_arena_restore(v.state, arenaIdx)
 		return nil, newExceptionValue(v.state)
 	}
 
+	// Restore the arena to sweep out everything allocated above, then
+	// re-root result on the now-shrunk arena so it survives the
+	// restore instead of being wiped out by it.
+	C.mrb_gc_arena_restore(v.state, arenaIdx)
+	C.mrb_gc_protect(v.state, result)
+
 	return newValue(v.state, result), nil
 }
 
@@ -137,6 +154,13 @@ func (v *MrbValue) IsDead() bool {
 	return C.ushort(C.mrb_object_dead_p(v.state, C._go_mrb_basic_ptr(v.value))) != 0
 }
 
+// IsNil tells you if this value is Ruby's nil. mruby represents nil and
+// false with the same type tag (TypeFalse), so this is the only way to
+// tell them apart.
+func (v *MrbValue) IsNil() bool {
+	return C.mrb_nil_p(v.value) != 0
+}
+
 // MrbValue so that *MrbValue implements the "Value" interface.
 func (v *MrbValue) MrbValue(*Mrb) *MrbValue {
 	return v
@@ -163,13 +187,34 @@ func (v *MrbValue) Type() ValueType {
 type Exception struct {
 	*MrbValue
 
+	// Message is the exception's string representation (the result of
+	// `to_s`), cached at construction time.
+	Message string
+
+	// File and Line are parsed from the innermost backtrace frame. If
+	// the backtrace is empty or the frame can't be parsed, they default
+	// to "Unknown" and 0, respectively.
+	File string
+	Line int
+
+	// Backtrace holds the raw Ruby backtrace, one frame per entry, in
+	// the format mruby produces them ("file:line").
+	Backtrace []string
+
 	// A cache of the string value of the exception. This is set in
 	// newExceptionValue so that the exception error string doesn't rely
 	// on the mruby state being available.
 	cachedString string
 }
 
+// Error implements the error interface. When the backtrace yielded a
+// file and line, they're prefixed onto the message, mirroring how Ruby
+// itself reports an uncaught exception.
 func (e *Exception) Error() string {
+	if e.File != "" && e.File != "Unknown" {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.String())
+	}
+
 	return e.String()
 }
 
@@ -181,6 +226,12 @@ func (e *Exception) String() string {
 	return e.MrbValue.String()
 }
 
+// BacktraceString joins the exception's backtrace frames with newlines,
+// the same way Ruby prints a backtrace.
+func (e *Exception) BacktraceString() string {
+	return strings.Join(e.Backtrace, "\n")
+}
+
 //-------------------------------------------------------------------
 // Type conversions to Go types
 //-------------------------------------------------------------------
@@ -247,11 +298,48 @@ func newExceptionValue(s *C.mrb_state) *Exception {
 		panic("exception value init without exception")
 	}
 
+	// Walking the backtrace allocates transient Ruby strings; reclaim
+	// them once we've copied what we need into Go.
+	arenaIdx := C.mrb_gc_arena_save(s)
+	defer C.mrb_gc_arena_restore(s, arenaIdx)
+
 	// Convert the RObject* to an mrb_value
 	value := C.mrb_obj_value(unsafe.Pointer(s.exc))
 
 	result := newValue(s, value)
-	return &Exception{MrbValue: result, cachedString: result.String()}
+	exc := &Exception{
+		MrbValue:     result,
+		cachedString: result.String(),
+		Message:      result.String(),
+		File:         "Unknown",
+		Line:         0,
+	}
+
+	bt := newValue(s, C.mrb_exc_backtrace(s, value))
+	if bt.Type() == TypeArray {
+		if n, err := bt.Array().Len(); err == nil {
+			exc.Backtrace = make([]string, 0, n)
+			for i := 0; i < n; i++ {
+				frame, err := bt.Array().Get(i)
+				if err != nil {
+					continue
+				}
+				exc.Backtrace = append(exc.Backtrace, frame.String())
+			}
+		}
+	}
+
+	if len(exc.Backtrace) > 0 {
+		parts := strings.Split(exc.Backtrace[0], ":")
+		if len(parts) >= 2 {
+			if line, err := strconv.Atoi(parts[1]); err == nil {
+				exc.File = parts[0]
+				exc.Line = line
+			}
+		}
+	}
+
+	return exc
 }
 
 func newValue(s *C.mrb_state, v C.mrb_value) *MrbValue {